@@ -0,0 +1,115 @@
+package main
+
+import (
+	"math"
+
+	"github.com/hajimehoshi/ebiten/v2/audio"
+)
+
+type audioBusKey string
+
+const (
+	audioBusSFX audioBusKey = "sfx"
+	audioBusBGM audioBusKey = "bgm"
+)
+
+type audioBus struct {
+	context *audio.Context
+
+	sounds  map[string][]byte
+	players map[string]*audio.Player
+
+	bgmPlayer *audio.Player
+
+	sfxVolume float64
+	bgmVolume float64
+	sfxMuted  bool
+	bgmMuted  bool
+}
+
+func newAudioBus(context *audio.Context, bgmPlayer *audio.Player, sounds map[string][]byte) *audioBus {
+	return &audioBus{
+		context:   context,
+		sounds:    sounds,
+		players:   map[string]*audio.Player{},
+		bgmPlayer: bgmPlayer,
+		sfxVolume: 1.0,
+		bgmVolume: 1.0,
+	}
+}
+
+func (a *audioBus) PlaySound(key string) {
+	p, ok := a.players[key]
+	if !ok {
+		p = audio.NewPlayerFromBytes(a.context, a.sounds[key])
+		a.players[key] = p
+	}
+
+	p.SetVolume(a.appliedVolume(audioBusSFX))
+	p.Rewind()
+	p.Play()
+}
+
+func (a *audioBus) volume(bus audioBusKey) float64 {
+	switch bus {
+	case audioBusBGM:
+		return a.bgmVolume
+	default:
+		return a.sfxVolume
+	}
+}
+
+func (a *audioBus) muted(bus audioBusKey) bool {
+	switch bus {
+	case audioBusBGM:
+		return a.bgmMuted
+	default:
+		return a.sfxMuted
+	}
+}
+
+func (a *audioBus) appliedVolume(bus audioBusKey) float64 {
+	if a.muted(bus) {
+		return 0
+	}
+	return a.volume(bus)
+}
+
+func (a *audioBus) SetVolume(bus audioBusKey, v float64) {
+	v = math.Max(0, math.Min(1, v))
+
+	switch bus {
+	case audioBusBGM:
+		a.bgmVolume = v
+		a.bgmPlayer.SetVolume(a.appliedVolume(audioBusBGM))
+	default:
+		a.sfxVolume = v
+	}
+}
+
+func (a *audioBus) IncreaseVolume(bus audioBusKey) {
+	a.SetVolume(bus, a.volume(bus)+0.1)
+}
+
+func (a *audioBus) DecreaseVolume(bus audioBusKey) {
+	a.SetVolume(bus, a.volume(bus)-0.1)
+}
+
+func (a *audioBus) PlayBGM() {
+	a.bgmPlayer.Rewind()
+	a.bgmPlayer.Play()
+}
+
+func (a *audioBus) PauseBGM() {
+	a.bgmPlayer.Pause()
+}
+
+func (a *audioBus) Mute(bus audioBusKey) {
+	switch bus {
+	case audioBusBGM:
+		a.bgmMuted = !a.bgmMuted
+		a.bgmPlayer.SetVolume(a.appliedVolume(audioBusBGM))
+	default:
+		a.sfxMuted = !a.sfxMuted
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import "math"
+
+type Difficulty struct {
+	Name           string
+	Amplitude      float64
+	ResetInterval  uint64
+	CoriolisSpeed  float64
+	EnemyRateScale float64
+}
+
+var difficulties = []Difficulty{
+	{Name: "EASY", Amplitude: 220, ResetInterval: 600, CoriolisSpeed: math.Pi / 1000, EnemyRateScale: 1.5},
+	{Name: "NORMAL", Amplitude: 220, ResetInterval: 480, CoriolisSpeed: math.Pi / 800, EnemyRateScale: 1.0},
+	{Name: "HARD", Amplitude: 240, ResetInterval: 420, CoriolisSpeed: math.Pi / 700, EnemyRateScale: 0.75},
+	{Name: "INSANE", Amplitude: 260, ResetInterval: 360, CoriolisSpeed: math.Pi / 600, EnemyRateScale: 0.5},
+}
+
+const defaultDifficultyIndex = 1
+
+func (d Difficulty) enemyAppearanceRate(baseRate int) int {
+	rate := int(float64(baseRate) * d.EnemyRateScale)
+	if rate < 1 {
+		rate = 1
+	}
+	return rate
+}
@@ -0,0 +1,106 @@
+package input
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+	"github.com/tsujio/game-util/touchutil"
+)
+
+type Action int
+
+const (
+	ActionConfirm Action = iota
+	ActionHold
+	ActionVolumeUp
+	ActionVolumeDown
+	ActionMute
+	ActionLatitudeUp
+	ActionLatitudeDown
+)
+
+// Any face button or shoulder bumper counts as a confirm press.
+var confirmButtons = []ebiten.StandardGamepadButton{
+	ebiten.StandardGamepadButtonRightBottom,
+	ebiten.StandardGamepadButtonRightRight,
+	ebiten.StandardGamepadButtonRightLeft,
+	ebiten.StandardGamepadButtonRightTop,
+	ebiten.StandardGamepadButtonFrontTopLeft,
+	ebiten.StandardGamepadButtonFrontTopRight,
+}
+
+var holdButtons = []ebiten.StandardGamepadButton{
+	ebiten.StandardGamepadButtonFrontBottomLeft,
+	ebiten.StandardGamepadButtonFrontBottomRight,
+}
+
+var latitudeUpButtons = []ebiten.StandardGamepadButton{
+	ebiten.StandardGamepadButtonLeftTop,
+}
+
+var latitudeDownButtons = []ebiten.StandardGamepadButton{
+	ebiten.StandardGamepadButtonLeftBottom,
+}
+
+type Context struct {
+	touch *touchutil.TouchContext
+}
+
+func NewContext(touch *touchutil.TouchContext) *Context {
+	return &Context{touch: touch}
+}
+
+func anyGamepadButton(pred func(ebiten.GamepadID, ebiten.StandardGamepadButton) bool, buttons []ebiten.StandardGamepadButton) bool {
+	for _, id := range ebiten.GamepadIDs() {
+		for _, b := range buttons {
+			if pred(id, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *Context) JustPressed(action Action) bool {
+	switch action {
+	case ActionConfirm:
+		return c.touch.IsJustTouched() ||
+			inpututil.IsKeyJustPressed(ebiten.KeyEnter) ||
+			anyGamepadButton(inpututil.IsStandardGamepadButtonJustPressed, confirmButtons)
+	case ActionHold:
+		return c.touch.IsJustTouched() ||
+			inpututil.IsKeyJustPressed(ebiten.KeySpace) ||
+			anyGamepadButton(inpututil.IsStandardGamepadButtonJustPressed, holdButtons)
+	case ActionVolumeUp:
+		return inpututil.IsKeyJustPressed(ebiten.KeyEqual)
+	case ActionVolumeDown:
+		return inpututil.IsKeyJustPressed(ebiten.KeyMinus)
+	case ActionMute:
+		return inpututil.IsKeyJustPressed(ebiten.KeyM)
+	}
+	return false
+}
+
+func (c *Context) JustReleased(action Action) bool {
+	switch action {
+	case ActionHold:
+		return c.touch.IsJustReleased() ||
+			inpututil.IsKeyJustReleased(ebiten.KeySpace) ||
+			anyGamepadButton(inpututil.IsStandardGamepadButtonJustReleased, holdButtons)
+	}
+	return false
+}
+
+func (c *Context) Pressed(action Action) bool {
+	switch action {
+	case ActionHold:
+		return ebiten.IsKeyPressed(ebiten.KeySpace) ||
+			anyGamepadButton(ebiten.IsStandardGamepadButtonPressed, holdButtons)
+	case ActionLatitudeUp:
+		return ebiten.IsKeyPressed(ebiten.KeyArrowUp) ||
+			anyGamepadButton(ebiten.IsStandardGamepadButtonPressed, latitudeUpButtons)
+	case ActionLatitudeDown:
+		return ebiten.IsKeyPressed(ebiten.KeyArrowDown) ||
+			anyGamepadButton(ebiten.IsStandardGamepadButtonPressed, latitudeDownButtons)
+	}
+	return false
+}
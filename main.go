@@ -16,9 +16,12 @@ import (
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 	"github.com/hajimehoshi/ebiten/v2/text"
 	"github.com/hajimehoshi/ebiten/v2/vector"
 	"github.com/samber/lo"
+	"github.com/tsujio/game-foucault-pendulum/input"
+	"github.com/tsujio/game-foucault-pendulum/save"
 	logging "github.com/tsujio/game-logging-server/client"
 	"github.com/tsujio/game-util/drawutil"
 	"github.com/tsujio/game-util/loggingutil"
@@ -37,6 +40,12 @@ const (
 	pendulumAmplitude   = 220
 	pendulumR           = 10.0
 	gravity             = 9.8 / 60
+
+	siderealDayTicks    = 3600
+	siderealRotation    = 2 * math.Pi / siderealDayTicks
+	defaultLatitude     = math.Pi / 4
+	trailMaxLength      = 600
+	latitudeAdjustSpeed = math.Pi / 240
 )
 
 //go:embed resources/*.ttf resources/*.dat resources/bgm-*.wav resources/secret
@@ -82,10 +91,10 @@ type Mover struct {
 	delta float64
 }
 
-func (m *Mover) move(ticks uint, pos *PolarCoordinates) {
+func (m *Mover) move(ticks uint, pos *PolarCoordinates, scale float64) {
 	if int(ticks)-m.delay > 0 {
 		x, y := pos.toScreen()
-		pos.fromScreen(x+m.delta, y)
+		pos.fromScreen(x+m.delta*scale, y)
 	}
 }
 
@@ -124,14 +133,17 @@ func (c *Coin) draw(screen *ebiten.Image) {
 }
 
 type CoinHitEffect struct {
-	ticks uint
-	pos   *PolarCoordinates
-	gain  int
+	ticks         uint
+	pos           *PolarCoordinates
+	gain          int
+	reducedMotion bool
 }
 
 func (e *CoinHitEffect) draw(screen *ebiten.Image) {
 	x, y := e.pos.toScreen()
-	y -= 10.0 * math.Sin(float64(e.ticks)*math.Pi/60)
+	if !e.reducedMotion {
+		y -= 10.0 * math.Sin(float64(e.ticks)*math.Pi/60)
+	}
 	text.Draw(screen, fmt.Sprintf("%+d", e.gain), fontM.Face, int(x), int(y), color.RGBA{0xff, 0xff, 0, 0xff})
 }
 
@@ -140,6 +152,7 @@ type Enemy struct {
 	pos   *PolarCoordinates
 	mover *Mover
 	r     float64
+	hit   bool
 }
 
 var enemyImages = drawutil.CreatePatternImageArray([][][]rune{
@@ -182,6 +195,43 @@ func (e *Enemy) draw(screen *ebiten.Image) {
 	drawutil.DrawImageAt(screen, img, x, y, opts)
 }
 
+type PowerupKind int
+
+const (
+	PowerupShieldEarth PowerupKind = iota
+	PowerupSlowTime
+	PowerupMagnet
+	PowerupDoubleScore
+	powerupKindCount
+)
+
+const (
+	powerupEffectDuration = 600
+	powerupLifetime       = 480
+	powerupAppearanceRate = 900
+	magnetPullSpeed       = 2.0
+)
+
+type Powerup struct {
+	ticks uint
+	pos   *PolarCoordinates
+	kind  PowerupKind
+	r     float64
+	hit   bool
+}
+
+var powerupColors = [...]color.Color{
+	PowerupShieldEarth: color.RGBA{0x40, 0x80, 0xff, 0xff},
+	PowerupSlowTime:    color.RGBA{0x80, 0x40, 0xff, 0xff},
+	PowerupMagnet:      color.RGBA{0xff, 0x40, 0x40, 0xff},
+	PowerupDoubleScore: color.RGBA{0x40, 0xff, 0x80, 0xff},
+}
+
+func (p *Powerup) draw(screen *ebiten.Image) {
+	x, y := p.pos.toScreen()
+	ebitenutil.DrawCircle(screen, x, y, p.r, powerupColors[p.kind])
+}
+
 type GameMode int
 
 const (
@@ -195,6 +245,7 @@ type Game struct {
 	playID             string
 	fixedRandomSeed    int64
 	touchContext       *touchutil.TouchContext
+	input              *input.Context
 	random             *rand.Rand
 	mode               GameMode
 	ticksFromModeStart uint64
@@ -202,14 +253,107 @@ type Game struct {
 	hold               bool
 	pendulumX          float64
 	pendulumVx         float64
+	pendulumY          float64
+	pendulumVy         float64
 	pendulumRotation   float64
+	realisticMode      bool
+	latitude           float64
+	latitudeSet        bool
+	trail              []trailPoint
 	skyImg             *ebiten.Image
 	coins              []*Coin
 	coinHitEffects     []*CoinHitEffect
 	enemies            []*Enemy
+	powerups           []*Powerup
+	activePowerupTicks [powerupKindCount]int
 	lastPendulumTicks  uint64
+	saveState          *save.SaveState
+	newRecord          bool
+	audio              *audioBus
+	difficulty         Difficulty
+	isDaily            bool
+}
+
+func (g *Game) syncAudioSettings() {
+	g.saveState.Settings.SFXVolume = g.audio.volume(audioBusSFX)
+	g.saveState.Settings.BGMVolume = g.audio.volume(audioBusBGM)
+	g.saveState.Settings.SFXMuted = g.audio.muted(audioBusSFX)
+	g.saveState.Settings.BGMMuted = g.audio.muted(audioBusBGM)
+	g.saveState.Save(gameName)
+}
+
+func (g *Game) bobXY() (x, y float64) {
+	if g.realisticMode {
+		return g.pendulumX, g.pendulumY
+	}
+	return g.pendulumX * math.Cos(g.pendulumRotation), g.pendulumX * math.Sin(g.pendulumRotation)
 }
 
+func (g *Game) pendulumBobPos() (r, theta float64) {
+	x, y := g.bobXY()
+	return math.Hypot(x, y), math.Atan2(y, x)
+}
+
+type trailPoint struct {
+	x, y float64
+}
+
+func (g *Game) dailyChallengePlayedToday() bool {
+	if g.saveState.LastDailyPlayedAt == 0 {
+		return false
+	}
+	last := time.Unix(g.saveState.LastDailyPlayedAt, 0).UTC()
+	return last.Equal(time.Now().UTC().Truncate(24 * time.Hour))
+}
+
+func (g *Game) runSeed() int64 {
+	switch {
+	case g.isDaily:
+		return time.Now().UTC().Truncate(24 * time.Hour).Unix()
+	case g.fixedRandomSeed != 0:
+		return g.fixedRandomSeed
+	default:
+		return time.Now().Unix()
+	}
+}
+
+func (g *Game) startGame() {
+	g.pendulumX = g.difficulty.Amplitude
+	g.random = rand.New(rand.NewSource(g.runSeed()))
+
+	g.setNextMode(GameModePlaying)
+
+	loggingutil.SendLog(gameName, g.playerID, g.playID, map[string]interface{}{
+		"action":     "start_game",
+		"difficulty": g.difficulty.Name,
+		"daily":      g.isDaily,
+	})
+
+	g.audio.PlaySound("gameStart")
+	g.audio.PlayBGM()
+}
+
+var difficultyRects = func() []image.Rectangle {
+	rects := make([]image.Rectangle, len(difficulties))
+	x := 20
+	for i := range difficulties {
+		rects[i] = image.Rect(x, 175, x+140, 205)
+		x += 150
+	}
+	return rects
+}()
+
+func difficultyRectIndex(p image.Point) int {
+	for i, r := range difficultyRects {
+		if p.In(r) {
+			return i
+		}
+	}
+	return -1
+}
+
+var dailyRect = image.Rect(120, 215, 520, 245)
+
 func (g *Game) Update() error {
 	g.touchContext.Update()
 
@@ -220,18 +364,48 @@ func (g *Game) Update() error {
 	switch g.mode {
 	case GameModeTitle:
 		if g.touchContext.IsJustTouched() {
-			g.pendulumX = pendulumAmplitude
-
-			g.setNextMode(GameModePlaying)
-
-			loggingutil.SendLog(gameName, g.playerID, g.playID, map[string]interface{}{
-				"action": "start_game",
-			})
-
-			audio.NewPlayerFromBytes(audioContext, gameStartAudioData).Play()
+			x, y := touchedPosition()
+			p := image.Pt(x, y)
+
+			switch {
+			case p.In(volDownRect):
+				g.audio.DecreaseVolume(audioBusBGM)
+				g.syncAudioSettings()
+			case p.In(volUpRect):
+				g.audio.IncreaseVolume(audioBusBGM)
+				g.syncAudioSettings()
+			case p.In(motionRect):
+				g.saveState.Settings.ReducedMotion = !g.saveState.Settings.ReducedMotion
+				g.saveState.Save(gameName)
+			case p.In(realisticRect):
+				g.realisticMode = !g.realisticMode
+				g.saveState.Settings.RealisticMode = g.realisticMode
+				g.saveState.Save(gameName)
+			case difficultyRectIndex(p) >= 0:
+				g.difficulty = difficulties[difficultyRectIndex(p)]
+			case p.In(dailyRect) && !g.dailyChallengePlayedToday():
+				g.isDaily = true
+				g.startGame()
+			default:
+				g.isDaily = false
+				g.startGame()
+			}
+		} else if g.input.JustPressed(input.ActionConfirm) {
+			g.isDaily = false
+			g.startGame()
+		}
 
-			bgmPlayer.Rewind()
-			bgmPlayer.Play()
+		if g.input.JustPressed(input.ActionVolumeUp) {
+			g.audio.IncreaseVolume(audioBusBGM)
+			g.syncAudioSettings()
+		}
+		if g.input.JustPressed(input.ActionVolumeDown) {
+			g.audio.DecreaseVolume(audioBusBGM)
+			g.syncAudioSettings()
+		}
+		if g.input.JustPressed(input.ActionMute) {
+			g.audio.Mute(audioBusBGM)
+			g.syncAudioSettings()
 		}
 	case GameModePlaying:
 		if g.ticksFromModeStart%600 == 0 {
@@ -242,35 +416,73 @@ func (g *Game) Update() error {
 			})
 		}
 
-		if g.touchContext.IsJustTouched() {
+		if g.input.JustPressed(input.ActionHold) {
 			g.hold = true
 		}
-		if g.touchContext.IsJustReleased() {
+		if g.input.JustReleased(input.ActionHold) {
 			g.hold = false
 		}
 
-		if g.hold {
-			g.pendulumRotation += math.Pi / 800
-			if g.pendulumRotation > math.Pi*2 {
-				g.pendulumRotation -= math.Pi * 2
+		if g.realisticMode {
+			if g.hold {
+				if _, y, ok := currentTouchPosition(); ok {
+					g.latitude = math.Min(1, math.Abs(float64(y)-circleY)/(screenHeight/2)) * (math.Pi / 2)
+					g.latitudeSet = true
+				} else if g.input.Pressed(input.ActionLatitudeUp) {
+					g.latitude = math.Min(math.Pi/2, g.latitude+latitudeAdjustSpeed)
+					g.latitudeSet = true
+				} else if g.input.Pressed(input.ActionLatitudeDown) {
+					g.latitude = math.Max(0, g.latitude-latitudeAdjustSpeed)
+					g.latitudeSet = true
+				}
+			}
+
+			omega := siderealRotation * math.Sin(g.latitude)
+
+			ax := -gravity/pendulumLength*g.pendulumX + 2*omega*g.pendulumVy
+			ay := -gravity/pendulumLength*g.pendulumY - 2*omega*g.pendulumVx
+
+			g.pendulumVx += ax
+			g.pendulumVy += ay
+			g.pendulumX += g.pendulumVx
+			g.pendulumY += g.pendulumVy
+
+			g.pendulumRotation = math.Atan2(g.pendulumY, g.pendulumX)
+
+			g.trail = append(g.trail, trailPoint{g.pendulumX, g.pendulumY})
+			if len(g.trail) > trailMaxLength {
+				g.trail = g.trail[len(g.trail)-trailMaxLength:]
+			}
+
+			if (g.ticksFromModeStart-g.lastPendulumTicks)%g.difficulty.ResetInterval == 0 {
+				g.pendulumVx, g.pendulumVy = 0, 0
+				g.pendulumX, g.pendulumY = g.difficulty.Amplitude, 0
+				g.lastPendulumTicks = g.ticksFromModeStart
+			}
+		} else {
+			if g.hold {
+				g.pendulumRotation += g.difficulty.CoriolisSpeed
+				if g.pendulumRotation > math.Pi*2 {
+					g.pendulumRotation -= math.Pi * 2
+				}
 			}
-		}
 
-		g.pendulumVx += -gravity / pendulumLength * g.pendulumX
-		g.pendulumX += g.pendulumVx
+			g.pendulumVx += -gravity / pendulumLength * g.pendulumX
+			g.pendulumX += g.pendulumVx
 
-		if (g.ticksFromModeStart-g.lastPendulumTicks)%480 == 0 {
-			g.pendulumVx = 0
-			g.pendulumX = pendulumAmplitude
-			g.lastPendulumTicks = g.ticksFromModeStart
+			if (g.ticksFromModeStart-g.lastPendulumTicks)%g.difficulty.ResetInterval == 0 {
+				g.pendulumVx = 0
+				g.pendulumX = g.difficulty.Amplitude
+				g.lastPendulumTicks = g.ticksFromModeStart
+			}
 		}
 
-		enemyAppearanceRate := lo.
+		enemyAppearanceRate := g.difficulty.enemyAppearanceRate(lo.
 			If(g.ticksFromModeStart < 1800, 180).
 			ElseIf(g.ticksFromModeStart < 2400, 120).
 			ElseIf(g.ticksFromModeStart < 3000, 100).
 			ElseIf(g.ticksFromModeStart < 3600, 60).
-			Else(40)
+			Else(40))
 
 		if g.random.Int()%enemyAppearanceRate == 0 {
 			x := lo.If(g.random.Int()%2 == 0, -50.0).Else(screenWidth + 50.0)
@@ -310,30 +522,58 @@ func (g *Game) Update() error {
 			g.enemies = append(g.enemies, e)
 		}
 
+		bobX, bobY := g.bobXY()
+
 		for _, c := range g.coins {
-			if math.Pow(g.pendulumX*math.Cos(g.pendulumRotation)-c.pos.r*math.Cos(c.pos.theta), 2)+
-				math.Pow(g.pendulumX*math.Sin(g.pendulumRotation)-c.pos.r*math.Sin(c.pos.theta), 2) <
+			if math.Pow(bobX-c.pos.r*math.Cos(c.pos.theta), 2)+
+				math.Pow(bobY-c.pos.r*math.Sin(c.pos.theta), 2) <
 				math.Pow(pendulumR+c.r, 2) {
 				c.hit = true
 
 				gain := lo.If(c.r <= 5.0, 100).ElseIf(c.r <= 7.0, 300).Else(1000)
 
+				if g.activePowerupTicks[PowerupDoubleScore] > 0 {
+					gain *= 2
+				}
+
 				g.coinHitEffects = append(g.coinHitEffects, &CoinHitEffect{
 					pos: &PolarCoordinates{
 						r:     c.pos.r,
 						theta: c.pos.theta,
 					},
-					gain: gain,
+					gain:          gain,
+					reducedMotion: g.saveState.Settings.ReducedMotion,
 				})
 
 				g.score += gain
+				g.saveState.TotalCoins++
 
-				audio.NewPlayerFromBytes(audioContext, scoreUpAudioData).Play()
+				g.audio.PlaySound("scoreUp")
+			}
+
+			if g.activePowerupTicks[PowerupMagnet] > 0 {
+				bobR, bobTheta := g.pendulumBobPos()
+				if math.Abs(c.pos.r-bobR) < 200 {
+					if c.pos.r > bobR {
+						c.pos.r = math.Max(bobR, c.pos.r-magnetPullSpeed)
+					} else {
+						c.pos.r = math.Min(bobR, c.pos.r+magnetPullSpeed)
+					}
+
+					dt := bobTheta - c.pos.theta
+					for dt > math.Pi {
+						dt -= math.Pi * 2
+					}
+					for dt < -math.Pi {
+						dt += math.Pi * 2
+					}
+					c.pos.theta += dt * 0.1
+				}
 			}
 
 			c.ticks++
 
-			c.mover.move(c.ticks, c.pos)
+			c.mover.move(c.ticks, c.pos, 1.0)
 		}
 
 		for _, e := range g.coinHitEffects {
@@ -341,26 +581,48 @@ func (g *Game) Update() error {
 		}
 
 		for _, e := range g.enemies {
-			if math.Pow(g.pendulumX*math.Cos(g.pendulumRotation)-e.pos.r*math.Cos(e.pos.theta), 2)+
-				math.Pow(g.pendulumX*math.Sin(g.pendulumRotation)-e.pos.r*math.Sin(e.pos.theta), 2) <
+			if math.Pow(bobX-e.pos.r*math.Cos(e.pos.theta), 2)+
+				math.Pow(bobY-e.pos.r*math.Sin(e.pos.theta), 2) <
 				math.Pow(pendulumR+e.r, 2) {
-				loggingutil.SendLog(gameName, g.playerID, g.playID, map[string]interface{}{
-					"action": "game_over",
-					"score":  g.score,
-				})
-
-				g.setNextMode(GameModeGameOver)
+				if g.activePowerupTicks[PowerupShieldEarth] > 0 {
+					g.activePowerupTicks[PowerupShieldEarth] = 0
+					e.hit = true
+				} else {
+					loggingutil.SendLog(gameName, g.playerID, g.playID, map[string]interface{}{
+						"action": "game_over",
+						"score":  g.score,
+					})
+
+					g.setNextMode(GameModeGameOver)
+
+					g.newRecord = g.score > g.saveState.HighScore
+					if g.newRecord {
+						g.saveState.HighScore = g.score
+					}
+					if g.isDaily {
+						g.saveState.LastDailyPlayedAt = time.Now().UTC().Truncate(24 * time.Hour).Unix()
+					}
+					g.saveState.Save(gameName)
+
+					leaderboard := gameName
+					if g.isDaily {
+						leaderboard = gameName + "-daily"
+					}
+					loggingutil.RegisterScoreToRankingAsync(leaderboard, g.playerID, g.playID, g.score)
+
+					g.audio.PlaySound("gameOver")
+					if g.newRecord {
+						g.audio.PlaySound("scoreUp")
+					}
 
-				loggingutil.RegisterScoreToRankingAsync(gameName, g.playerID, g.playID, g.score)
-
-				audio.NewPlayerFromBytes(audioContext, gameOverAudioData).Play()
-
-				break
+					break
+				}
 			}
 
 			e.ticks++
 
-			e.mover.move(e.ticks, e.pos)
+			slowScale := lo.If(g.activePowerupTicks[PowerupSlowTime] > 0, 0.5).Else(1.0)
+			e.mover.move(e.ticks, e.pos, slowScale)
 		}
 
 		g.coins = lo.Filter(g.coins, func(c *Coin, _ int) bool {
@@ -374,13 +636,52 @@ func (g *Game) Update() error {
 
 		g.enemies = lo.Filter(g.enemies, func(e *Enemy, _ int) bool {
 			x, y := e.pos.toScreen()
-			return x > -100 && x < screenWidth+100 && y > -100 && y < screenHeight+100
+			return x > -100 && x < screenWidth+100 && y > -100 && y < screenHeight+100 && !e.hit
 		})
 
+		for _, p := range g.powerups {
+			if math.Pow(bobX-p.pos.r*math.Cos(p.pos.theta), 2)+
+				math.Pow(bobY-p.pos.r*math.Sin(p.pos.theta), 2) <
+				math.Pow(pendulumR+p.r, 2) {
+				p.hit = true
+
+				g.activePowerupTicks[p.kind] = powerupEffectDuration
+
+				g.audio.PlaySound("scoreUp")
+			}
+
+			p.ticks++
+		}
+
+		g.powerups = lo.Filter(g.powerups, func(p *Powerup, _ int) bool {
+			return p.ticks < powerupLifetime && !p.hit
+		})
+
+		for k := range g.activePowerupTicks {
+			if g.activePowerupTicks[k] > 0 {
+				g.activePowerupTicks[k]--
+			}
+		}
+
+		if g.ticksFromModeStart%powerupAppearanceRate == 0 {
+			kind := PowerupKind(g.random.Int() % int(powerupKindCount))
+
+			pos := &PolarCoordinates{
+				r:     g.difficulty.Amplitude * g.random.Float64(),
+				theta: math.Pi * 2 * g.random.Float64(),
+			}
+
+			g.powerups = append(g.powerups, &Powerup{
+				pos:  pos,
+				kind: kind,
+				r:    8,
+			})
+		}
+
 	case GameModeGameOver:
-		if g.ticksFromModeStart > 60 && g.touchContext.IsJustTouched() {
+		if g.ticksFromModeStart > 60 && g.input.JustPressed(input.ActionConfirm) {
 			g.initialize()
-			bgmPlayer.Pause()
+			g.audio.PauseBGM()
 		}
 	}
 
@@ -393,22 +694,100 @@ func (g *Game) drawTitleText(screen *ebiten.Image) {
 		text.Draw(screen, s, fontL.Face, screenWidth/2-len(s)*int(fontL.FaceOptions.Size)/2, 110+i*int(fontL.FaceOptions.Size*1.8), color.White)
 	}
 
-	usageTexts := []string{"[HOLD] Rotate the Earth"}
+	hi := fmt.Sprintf("HI %d", g.saveState.HighScore)
+	text.Draw(screen, hi, fontS.Face, screenWidth/2-len(hi)*int(fontS.FaceOptions.Size)/2, 150, color.White)
+
+	g.drawDifficultyOptions(screen)
+
+	usageTexts := []string{lo.If(g.realisticMode, "[HOLD] Set the latitude").Else("[HOLD] Rotate the Earth"), "Touch, Space, or gamepad trigger"}
 	for i, s := range usageTexts {
-		text.Draw(screen, s, fontS.Face, screenWidth/2-len(s)*int(fontS.FaceOptions.Size)/2, 310+i*int(fontS.FaceOptions.Size*1.8), color.White)
+		text.Draw(screen, s, fontS.Face, screenWidth/2-len(s)*int(fontS.FaceOptions.Size)/2, 270+i*int(fontS.FaceOptions.Size*1.8), color.White)
 	}
 
+	g.drawTitleOptions(screen)
+
 	creditTexts := []string{"CREATOR: NAOKI TSUJIO", "FONT: Press Start 2P by CodeMan38", "SOUND EFFECT: MaouDamashii", "POWERED BY Ebitengine"}
 	for i, s := range creditTexts {
 		text.Draw(screen, s, fontS.Face, screenWidth/2-len(s)*int(fontS.FaceOptions.Size)/2, 400+i*int(fontS.FaceOptions.Size*1.8), color.White)
 	}
 }
 
+var (
+	volDownRect   = image.Rect(110, 335, 200, 365)
+	volUpRect     = image.Rect(250, 335, 340, 365)
+	motionRect    = image.Rect(360, 335, 560, 365)
+	realisticRect = image.Rect(110, 365, 560, 395)
+)
+
+func (g *Game) drawDifficultyOptions(screen *ebiten.Image) {
+	for i, d := range difficulties {
+		label := d.Name
+		if d.Name == g.difficulty.Name {
+			label = "[" + label + "]"
+		}
+		r := difficultyRects[i]
+		text.Draw(screen, label, fontS.Face, r.Min.X, 195, color.White)
+	}
+
+	dailyLabel := "[TAP] DAILY CHALLENGE"
+	if g.dailyChallengePlayedToday() {
+		dailyLabel = "DAILY CHALLENGE DONE"
+	}
+	text.Draw(screen, dailyLabel, fontS.Face, dailyRect.Min.X, 235, color.White)
+}
+
+func (g *Game) drawTitleOptions(screen *ebiten.Image) {
+	text.Draw(screen, "VOL -", fontS.Face, volDownRect.Min.X, 355, color.White)
+	text.Draw(screen, "VOL +", fontS.Face, volUpRect.Min.X, 355, color.White)
+
+	motionText := lo.If(g.saveState.Settings.ReducedMotion, "MOTION: REDUCED").Else("MOTION: NORMAL")
+	text.Draw(screen, motionText, fontS.Face, motionRect.Min.X, 355, color.White)
+
+	modeText := lo.If(g.realisticMode, "[TAP] MODE: REALISTIC").Else("[TAP] MODE: ARCADE")
+	text.Draw(screen, modeText, fontS.Face, realisticRect.Min.X, 385, color.White)
+}
+
+func touchedPosition() (int, int) {
+	if ids := inpututil.JustPressedTouchIDs(); len(ids) > 0 {
+		x, y := ebiten.TouchPosition(ids[0])
+		return x, y
+	}
+	x, y := ebiten.CursorPosition()
+	return x, y
+}
+
+func currentTouchPosition() (x, y int, ok bool) {
+	if ids := ebiten.TouchIDs(); len(ids) > 0 {
+		x, y := ebiten.TouchPosition(ids[0])
+		return x, y, true
+	}
+	if ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		x, y := ebiten.CursorPosition()
+		return x, y, true
+	}
+	return 0, 0, false
+}
+
 func (g *Game) drawScore(screen *ebiten.Image) {
 	t := fmt.Sprintf("%d", g.score)
 	text.Draw(screen, t, fontS.Face, screenWidth-len(t)*int(fontS.FaceOptions.Size)-10, 25, color.White)
 }
 
+func (g *Game) drawPowerupHUD(screen *ebiten.Image) {
+	x := 10
+	for k := PowerupKind(0); k < powerupKindCount; k++ {
+		ticksLeft := g.activePowerupTicks[k]
+		if ticksLeft == 0 {
+			continue
+		}
+
+		ebitenutil.DrawCircle(screen, float64(x+8), 18, 8, powerupColors[k])
+		text.Draw(screen, fmt.Sprintf("%d", ticksLeft/60+1), fontS.Face, x+5, 23, color.Black)
+
+		x += 24
+	}
+}
+
 func (g *Game) drawGameOverText(screen *ebiten.Image) {
 	gameOverTexts := []string{"GAME OVER"}
 	for i, s := range gameOverTexts {
@@ -419,11 +798,19 @@ func (g *Game) drawGameOverText(screen *ebiten.Image) {
 	for i, s := range scoreText {
 		text.Draw(screen, s, fontM.Face, screenWidth/2-len(s)*int(fontM.FaceOptions.Size)/2, 230+i*int(fontM.FaceOptions.Size*1.8), color.White)
 	}
+
+	hi := fmt.Sprintf("HI %d", g.saveState.HighScore)
+	if g.newRecord {
+		hi = "NEW RECORD! " + hi
+	}
+	text.Draw(screen, hi, fontS.Face, screenWidth/2-len(hi)*int(fontS.FaceOptions.Size)/2, 300, color.White)
 }
 
 func (g *Game) drawSky(screen *ebiten.Image) {
 	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Rotate(g.pendulumRotation)
+	if !g.saveState.Settings.ReducedMotion {
+		op.GeoM.Rotate(g.pendulumRotation)
+	}
 	drawutil.DrawImageAt(screen, g.skyImg, screenWidth/2, -100, op)
 }
 
@@ -449,14 +836,39 @@ func (g *Game) drawSurface(screen *ebiten.Image) {
 }
 
 func (g *Game) drawPendulum(screen *ebiten.Image) {
-	x := g.pendulumX*math.Cos(g.pendulumRotation) + circleX
-	y := g.pendulumX*math.Sin(g.pendulumRotation)*circleVerticalScale + circleY
+	bobX, bobY := g.bobXY()
+	x := bobX + circleX
+	y := bobY*circleVerticalScale + circleY
 
 	clr := color.RGBA{0xe5, 0xe5, 0xe5, 0xff}
 	ebitenutil.DrawLine(screen, x, y, circleX, circleY-pendulumLength, clr)
 	ebitenutil.DrawCircle(screen, x, y, pendulumR, clr)
 }
 
+func (g *Game) drawTrail(screen *ebiten.Image) {
+	for i, p := range g.trail {
+		alpha := uint8(0xff * (i + 1) / len(g.trail))
+		x := p.x + circleX
+		y := p.y*circleVerticalScale + circleY
+		ebitenutil.DrawCircle(screen, x, y, 1.5, color.RGBA{0xe5, 0xe5, 0xe5, alpha})
+	}
+}
+
+var compassCenter = image.Pt(screenWidth-40, 80)
+
+func (g *Game) drawCompass(screen *ebiten.Image) {
+	const radius = 20
+
+	vector.StrokeCircle(screen, float32(compassCenter.X), float32(compassCenter.Y), radius, 1, color.RGBA{0x80, 0x80, 0x80, 0xff}, true)
+
+	bearing := math.Atan2(g.pendulumY, g.pendulumX)
+	dx, dy := math.Cos(bearing)*radius, math.Sin(bearing)*radius*circleVerticalScale
+
+	x0, y0 := float64(compassCenter.X)-dx, float64(compassCenter.Y)-dy
+	x1, y1 := float64(compassCenter.X)+dx, float64(compassCenter.Y)+dy
+	vector.StrokeLine(screen, float32(x0), float32(y0), float32(x1), float32(y1), 2, color.RGBA{0xff, 0xff, 0, 0xff}, true)
+}
+
 var circleImage = func() *ebiten.Image {
 	img := ebiten.NewImage(screenWidth, screenHeight)
 	img.Fill(color.Transparent)
@@ -468,14 +880,16 @@ var circleImage = func() *ebiten.Image {
 }()
 
 func (g *Game) drawCircle(screen *ebiten.Image) {
+	ampScale := g.difficulty.Amplitude / pendulumAmplitude
+
 	opts := &ebiten.DrawImageOptions{}
-	opts.GeoM.Scale(1.0, circleVerticalScale)
+	opts.GeoM.Scale(ampScale, circleVerticalScale*ampScale)
 	drawutil.DrawImageAt(screen, circleImage, circleX, circleY, opts)
 }
 
 func (g *Game) drawGuide(screen *ebiten.Image) {
 	pos := &PolarCoordinates{
-		r:     pendulumAmplitude,
+		r:     g.difficulty.Amplitude,
 		theta: g.pendulumRotation,
 	}
 	x0, y0 := pos.toScreen()
@@ -507,10 +921,18 @@ func (g *Game) Draw(screen *ebiten.Image) {
 
 		g.drawGuide(screen)
 
+		if g.realisticMode {
+			g.drawTrail(screen)
+		}
+
 		for _, c := range g.coins {
 			c.draw(screen)
 		}
 
+		for _, p := range g.powerups {
+			p.draw(screen)
+		}
+
 		for _, e := range g.enemies {
 			e.draw(screen)
 		}
@@ -522,6 +944,12 @@ func (g *Game) Draw(screen *ebiten.Image) {
 		}
 
 		g.drawScore(screen)
+
+		g.drawPowerupHUD(screen)
+
+		if g.realisticMode {
+			g.drawCompass(screen)
+		}
 	case GameModeGameOver:
 		g.drawSky(screen)
 
@@ -531,10 +959,18 @@ func (g *Game) Draw(screen *ebiten.Image) {
 
 		g.drawGuide(screen)
 
+		if g.realisticMode {
+			g.drawTrail(screen)
+		}
+
 		for _, c := range g.coins {
 			c.draw(screen)
 		}
 
+		for _, p := range g.powerups {
+			p.draw(screen)
+		}
+
 		for _, e := range g.enemies {
 			e.draw(screen)
 		}
@@ -547,6 +983,12 @@ func (g *Game) Draw(screen *ebiten.Image) {
 
 		g.drawScore(screen)
 
+		g.drawPowerupHUD(screen)
+
+		if g.realisticMode {
+			g.drawCompass(screen)
+		}
+
 		g.drawGameOverText(screen)
 	}
 }
@@ -584,12 +1026,22 @@ func (g *Game) initialize() {
 	g.hold = false
 	g.pendulumX = 0
 	g.pendulumVx = 0
+	g.pendulumY = 0
+	g.pendulumVy = 0
 	g.pendulumRotation = 0
+	if !g.latitudeSet {
+		g.latitude = defaultLatitude
+	}
+	g.trail = nil
 	g.skyImg = nil
 	g.coins = nil
 	g.coinHitEffects = nil
 	g.enemies = nil
+	g.powerups = nil
+	g.activePowerupTicks = [powerupKindCount]int{}
 	g.lastPendulumTicks = 0
+	g.newRecord = false
+	g.isDaily = false
 
 	skyImgLength := math.Max(screenWidth, screenHeight) * 1.2
 	skyImg := ebiten.NewImage(int(skyImgLength), int(skyImgLength))
@@ -627,10 +1079,29 @@ func main() {
 	ebiten.SetWindowSize(screenWidth, screenHeight)
 	ebiten.SetWindowTitle("Foucault Pendulum")
 
+	saveState := save.Load(gameName)
+
+	bus := newAudioBus(audioContext, bgmPlayer, map[string][]byte{
+		"gameStart": gameStartAudioData,
+		"gameOver":  gameOverAudioData,
+		"scoreUp":   scoreUpAudioData,
+	})
+	bus.SetVolume(audioBusSFX, saveState.Settings.SFXVolume)
+	bus.SetVolume(audioBusBGM, saveState.Settings.BGMVolume)
+	bus.sfxMuted = saveState.Settings.SFXMuted
+	bus.bgmMuted = saveState.Settings.BGMMuted
+
+	touchContext := touchutil.CreateTouchContext()
+
 	game := &Game{
 		playerID:        playerID,
 		fixedRandomSeed: randomSeed,
-		touchContext:    touchutil.CreateTouchContext(),
+		touchContext:    touchContext,
+		input:           input.NewContext(touchContext),
+		saveState:       saveState,
+		audio:           bus,
+		difficulty:      difficulties[defaultDifficultyIndex],
+		realisticMode:   saveState.Settings.RealisticMode,
 	}
 	game.initialize()
 
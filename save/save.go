@@ -0,0 +1,79 @@
+package save
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+)
+
+type Settings struct {
+	SFXVolume     float64 `json:"sfxVolume"`
+	BGMVolume     float64 `json:"bgmVolume"`
+	SFXMuted      bool    `json:"sfxMuted"`
+	BGMMuted      bool    `json:"bgmMuted"`
+	ReducedMotion bool    `json:"reducedMotion"`
+	RealisticMode bool    `json:"realisticMode"`
+}
+
+type SaveState struct {
+	HighScore         int      `json:"highScore"`
+	TotalCoins        int      `json:"totalCoins"`
+	Settings          Settings `json:"settings"`
+	LastDailyPlayedAt int64    `json:"lastDailyPlayedAt"` // Unix timestamp of the UTC day last played, 0 if never
+}
+
+func defaultState() *SaveState {
+	return &SaveState{
+		Settings: Settings{
+			SFXVolume: 1.0,
+			BGMVolume: 1.0,
+		},
+	}
+}
+
+func path(gameName string) (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, gameName, "save.json"), nil
+}
+
+// Load falls back to default state if the save file doesn't exist yet or can't be read.
+func Load(gameName string) *SaveState {
+	state := defaultState()
+
+	p, err := path(gameName)
+	if err != nil {
+		return state
+	}
+
+	data, err := os.ReadFile(p)
+	if err != nil {
+		return state
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return defaultState()
+	}
+
+	return state
+}
+
+func (s *SaveState) Save(gameName string) error {
+	p, err := path(gameName)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(p, data, 0644)
+}